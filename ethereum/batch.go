@@ -0,0 +1,90 @@
+package ethereum
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// RecoverRequest bundles the inputs to a single EcRecover call for use with BatchEcRecover.
+type RecoverRequest struct {
+	Hash common.Hash
+	VRS  []byte
+}
+
+// ecRecoverCacheSize bounds the number of recovered addresses kept in recoverCache.
+const ecRecoverCacheSize = 100000
+
+// recoverCache memoizes EcRecover results keyed by keccak256(hash ‖ VRS), so that repeated
+// verifications of the same signature (e.g. re-processing the same mempool tx across blocks)
+// skip the elliptic-curve work.
+var recoverCache, _ = lru.New[common.Hash, common.Address](ecRecoverCacheSize)
+
+// recoverCacheKey derives the recoverCache key for a (hash, VRS) pair.
+func recoverCacheKey(hash common.Hash, VRS []byte) common.Hash {
+	return crypto.Keccak256Hash(hash[:], VRS)
+}
+
+// BatchEcRecover runs EcRecover over items across a worker pool sized to runtime.GOMAXPROCS,
+// consulting and filling recoverCache along the way. It returns one address/error per item, in
+// the same order as items.
+func BatchEcRecover(items []RecoverRequest) ([]common.Address, []error) {
+	addrs := make([]common.Address, len(items))
+	errs := make([]error, len(items))
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(items) {
+		workers = len(items)
+	}
+	if workers == 0 {
+		return addrs, errs
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				addrs[idx], errs[idx] = recoverJob(items[idx])
+			}
+		}()
+	}
+	for i := range items {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return addrs, errs
+}
+
+// recoverJob runs cachedEcRecover for a single item, converting a panic (e.g. from a malformed
+// VRS) into an error so that one bad signature in a batch can't take down the whole worker pool.
+func recoverJob(item RecoverRequest) (addr common.Address, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			addr, err = common.Address{}, fmt.Errorf("ethereum: recovering signature: %v", r)
+		}
+	}()
+	return cachedEcRecover(item.Hash, item.VRS)
+}
+
+// cachedEcRecover is EcRecover with a recoverCache lookup/fill wrapped around it.
+func cachedEcRecover(hash common.Hash, VRS []byte) (common.Address, error) {
+	key := recoverCacheKey(hash, VRS)
+	if addr, ok := recoverCache.Get(key); ok {
+		return addr, nil
+	}
+	addr, err := EcRecover(hash, VRS)
+	if err != nil {
+		return common.Address{}, err
+	}
+	recoverCache.Add(key, addr)
+	return addr, nil
+}
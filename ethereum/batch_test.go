@@ -0,0 +1,70 @@
+package ethereum
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestBatchEcRecoverRoundTrip(t *testing.T) {
+	const n = 8
+	items := make([]RecoverRequest, n)
+	want := make([]common.Address, n)
+
+	for i := 0; i < n; i++ {
+		priv, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("GenerateKey: %v", err)
+		}
+		hash := crypto.Keccak256Hash([]byte{byte(i)})
+		sig, err := Sign(hash, priv)
+		if err != nil {
+			t.Fatalf("Sign: %v", err)
+		}
+		items[i] = RecoverRequest{Hash: hash, VRS: sig}
+		want[i] = crypto.PubkeyToAddress(priv.PublicKey)
+	}
+	// Repeat the first item so the recoverCache gets exercised too.
+	items = append(items, items[0])
+	want = append(want, want[0])
+
+	addrs, errs := BatchEcRecover(items)
+	for i := range items {
+		if errs[i] != nil {
+			t.Errorf("item %d: unexpected error: %v", i, errs[i])
+		}
+		if addrs[i] != want[i] {
+			t.Errorf("item %d: recovered %s, want %s", i, addrs[i], want[i])
+		}
+	}
+}
+
+func TestBatchEcRecoverRejectsMalformedItemWithoutPanic(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	hash := crypto.Keccak256Hash([]byte("ok"))
+	sig, err := Sign(hash, priv)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	items := []RecoverRequest{
+		{Hash: hash, VRS: sig},
+		{Hash: hash, VRS: []byte{27}}, // too short to slice VRS[1:65]
+	}
+
+	addrs, errs := BatchEcRecover(items)
+
+	if errs[0] != nil {
+		t.Errorf("item 0: unexpected error: %v", errs[0])
+	}
+	if addrs[0] != crypto.PubkeyToAddress(priv.PublicKey) {
+		t.Errorf("item 0: recovered %s, want %s", addrs[0], crypto.PubkeyToAddress(priv.PublicKey))
+	}
+	if errs[1] == nil {
+		t.Error("item 1: expected an error for the malformed signature, got nil")
+	}
+}
@@ -0,0 +1,33 @@
+package ethereum
+
+import "github.com/ethereum/go-ethereum/common"
+
+// EcRecoverCompact recovers the signing address from an EIP-2098 compact (64-byte) signature,
+// where yParity is packed into the top bit of s instead of carried as a separate v byte.
+func EcRecoverCompact(hash common.Hash, sig [64]byte) (common.Address, error) {
+	return EcRecover(hash, FromCompact(sig))
+}
+
+// ToCompact packs a 65-byte [V, R, S] signature, as produced by Sign and consumed by EcRecover,
+// into the 64-byte EIP-2098 compact form, folding yParity into the top bit of s.
+func ToCompact(VRS []byte) [64]byte {
+	var compact [64]byte
+	copy(compact[:32], VRS[1:33])
+	copy(compact[32:], VRS[33:65])
+	if yParity := VRS[0] - 27; yParity == 1 {
+		compact[32] |= 0x80
+	}
+	return compact
+}
+
+// FromCompact unpacks an EIP-2098 compact (64-byte) signature back into this package's 65-byte
+// [V, R, S] layout, ready for EcRecover.
+func FromCompact(sig [64]byte) []byte {
+	vrs := make([]byte, 65)
+	copy(vrs[1:33], sig[:32])
+	copy(vrs[33:65], sig[32:])
+	yParity := vrs[33] >> 7
+	vrs[33] &^= 0x80
+	vrs[0] = 27 + yParity
+	return vrs
+}
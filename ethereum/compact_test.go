@@ -0,0 +1,59 @@
+package ethereum
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestCompactRoundTrip(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	hash := crypto.Keccak256Hash([]byte("compact signature"))
+
+	vrs, err := Sign(hash, priv)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	compact := ToCompact(vrs)
+	if got := FromCompact(compact); !equalBytes(got, vrs) {
+		t.Fatalf("FromCompact(ToCompact(vrs)) = %x, want %x", got, vrs)
+	}
+}
+
+func TestEcRecoverCompactRoundTrip(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	want := crypto.PubkeyToAddress(priv.PublicKey)
+	hash := crypto.Keccak256Hash([]byte("compact signature"))
+
+	vrs, err := Sign(hash, priv)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	addr, err := EcRecoverCompact(hash, ToCompact(vrs))
+	if err != nil {
+		t.Fatalf("EcRecoverCompact: %v", err)
+	}
+	if addr != want {
+		t.Errorf("recovered %s, want %s", addr, want)
+	}
+}
+
+func equalBytes(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
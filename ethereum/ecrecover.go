@@ -17,6 +17,8 @@
 package ethereum
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -56,7 +58,7 @@ func PrecompiledEcRecover(input []byte) ([]byte, error) {
 		return nil, nil
 	}
 	// v needs to be at the end for libsecp256k1
-	pubKey, err := crypto.Ecrecover(input[:32], append(input[64:128], v))
+	pubKey, err := ecrecover(input[:32], append(input[64:128], v))
 	// make sure the public key is a valid one
 	if err != nil {
 		return nil, nil
@@ -74,6 +76,9 @@ func PrecompiledEcRecover(input []byte) ([]byte, error) {
 // It can be used to verify that a go implementation of ethereum signature and formatting complies with 
 // ecrecover standard through unit-tests
 func EcRecover(Hash common.Hash, VRS []byte) (common.Address, error) {
+	if len(VRS) != 65 {
+		return common.Address{}, fmt.Errorf("ethereum: invalid VRS length %d, want 65", len(VRS))
+	}
 	// All values are initialized to zero
 	input := make([]byte, 128)
 	// Copying the V element signature
@@ -87,6 +92,9 @@ func EcRecover(Hash common.Hash, VRS []byte) (common.Address, error) {
 	if err != nil {
 		return common.Address{}, err
 	}
+	if output == nil {
+		return common.Address{}, errors.New("ethereum: invalid signature")
+	}
 
 	// Extract the address from the returned slice
 	return common.BytesToAddress(output[12:32]), nil
@@ -0,0 +1,110 @@
+package ethereum
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestEcRecoverEIP155RoundTrip(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	want := crypto.PubkeyToAddress(priv.PublicKey)
+	chainID := big.NewInt(7)
+	hash := crypto.Keccak256Hash([]byte("eip-155 tx"))
+
+	vrs, err := Sign(hash, priv)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	yParity := vrs[0] - 27
+	eip155VRSBytes := make([]byte, len(vrs))
+	copy(eip155VRSBytes, vrs)
+	eip155VRSBytes[0] = byte(new(big.Int).Add(new(big.Int).Mul(chainID, big.NewInt(2)), big.NewInt(35+int64(yParity))).Uint64())
+
+	addr, err := EcRecoverEIP155(hash, eip155VRSBytes, chainID)
+	if err != nil {
+		t.Fatalf("EcRecoverEIP155: %v", err)
+	}
+	if addr != want {
+		t.Errorf("recovered %s, want %s", addr, want)
+	}
+}
+
+func TestEcRecoverEIP155RejectsWrongChainID(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	signingChainID := big.NewInt(7)
+	verifyingChainID := big.NewInt(8)
+	hash := crypto.Keccak256Hash([]byte("eip-155 tx"))
+
+	vrs, err := Sign(hash, priv)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	yParity := vrs[0] - 27
+	eip155VRSBytes := make([]byte, len(vrs))
+	copy(eip155VRSBytes, vrs)
+	eip155VRSBytes[0] = byte(new(big.Int).Add(new(big.Int).Mul(signingChainID, big.NewInt(2)), big.NewInt(35+int64(yParity))).Uint64())
+
+	addr, err := EcRecoverEIP155(hash, eip155VRSBytes, verifyingChainID)
+	if err == nil {
+		t.Fatalf("expected an error recovering with a mismatched chain ID, got address %s", addr)
+	}
+}
+
+func TestEcRecoverEIP155RejectsShortVRS(t *testing.T) {
+	_, err := EcRecoverEIP155(common.Hash{}, []byte{35}, big.NewInt(1))
+	if err == nil {
+		t.Fatal("expected an error for a short VRS, got nil")
+	}
+}
+
+// TestValidateTransactionSignatureMatchesSignTx cross-checks ValidateTransactionSignature's RLP
+// encoding against go-ethereum's own types.SignTx/EIP155Signer, so a future change to
+// unsignedTxRLP's field order/types can't silently drift from what real signed transactions hash.
+func TestValidateTransactionSignatureMatchesSignTx(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	want := crypto.PubkeyToAddress(priv.PublicKey)
+	chainID := big.NewInt(5)
+
+	to := common.HexToAddress("0x00000000000000000000000000000000000001")
+	tx := types.NewTransaction(42, to, big.NewInt(1000), 21000, big.NewInt(1), []byte("payload"))
+
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), priv)
+	if err != nil {
+		t.Fatalf("SignTx: %v", err)
+	}
+	v, r, s := signedTx.RawSignatureValues()
+
+	vrs := make([]byte, 65)
+	vrs[0] = byte(v.Uint64())
+	copy(vrs[1:33], common.LeftPadBytes(r.Bytes(), 32))
+	copy(vrs[33:65], common.LeftPadBytes(s.Bytes(), 32))
+
+	addr, err := ValidateTransactionSignature(signedTx.Nonce(), signedTx.GasPrice(), signedTx.Gas(), signedTx.To(), signedTx.Value(), signedTx.Data(), chainID, vrs)
+	if err != nil {
+		t.Fatalf("ValidateTransactionSignature: %v", err)
+	}
+	if addr != want {
+		t.Errorf("recovered %s, want %s", addr, want)
+	}
+
+	wantSender, err := types.Sender(types.NewEIP155Signer(chainID), signedTx)
+	if err != nil {
+		t.Fatalf("types.Sender: %v", err)
+	}
+	if addr != wantSender {
+		t.Errorf("recovered %s, want types.Sender %s", addr, wantSender)
+	}
+}
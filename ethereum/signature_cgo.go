@@ -0,0 +1,13 @@
+//go:build !nocgo
+
+package ethereum
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ecrecover recovers the uncompressed public key that created the given 65-byte [R || S || V]
+// signature over hash, using go-ethereum's cgo libsecp256k1 binding.
+func ecrecover(hash, sig []byte) ([]byte, error) {
+	return crypto.Ecrecover(hash, sig)
+}
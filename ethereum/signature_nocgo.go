@@ -0,0 +1,29 @@
+//go:build nocgo
+
+package ethereum
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+)
+
+// ecrecover recovers the uncompressed public key that created the given 65-byte [R || S || V]
+// signature over hash, using the pure-Go btcec implementation instead of cgo libsecp256k1. This
+// lets binaries importing this package cross-compile to platforms without a C toolchain (Wasm,
+// some ARM targets, distroless containers) while still returning identical results to the cgo path.
+func ecrecover(hash, sig []byte) ([]byte, error) {
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("ethereum: invalid signature length %d, want 65", len(sig))
+	}
+	// btcec expects the recovery byte first and offset by 27, standard ecrecover puts it last.
+	var compact [65]byte
+	compact[0] = sig[64] + 27
+	copy(compact[1:], sig[:64])
+
+	pub, _, err := ecdsa.RecoverCompact(compact[:], hash)
+	if err != nil {
+		return nil, err
+	}
+	return pub.SerializeUncompressed(), nil
+}
@@ -0,0 +1,76 @@
+package ethereum
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Sign signs hash with priv and returns a 65-byte [V, R, S] signature, in the same VRS layout
+// that EcRecover expects, so that Sign and EcRecover round-trip.
+func Sign(hash common.Hash, priv *ecdsa.PrivateKey) ([]byte, error) {
+	sig, err := crypto.Sign(hash[:], priv)
+	if err != nil {
+		return nil, err
+	}
+	// crypto.Sign returns R || S || V with V in {0, 1}; flip it into this package's [V, R, S].
+	vrs := make([]byte, 65)
+	vrs[0] = sig[64] + 27
+	copy(vrs[1:], sig[:64])
+	return vrs, nil
+}
+
+// SignBytes keccak256-hashes data and signs the result.
+func SignBytes(data []byte, priv *ecdsa.PrivateKey) ([]byte, error) {
+	return Sign(crypto.Keccak256Hash(data), priv)
+}
+
+// PersonalMessageHash returns the EIP-191 "personal_sign" digest of msg, i.e. the keccak256 of
+// "\x19Ethereum Signed Message:\n" followed by the decimal length of msg and msg itself.
+func PersonalMessageHash(msg []byte) common.Hash {
+	prefixed := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(msg), msg)
+	return crypto.Keccak256Hash([]byte(prefixed))
+}
+
+// SignPersonalMessage signs msg under the EIP-191 personal_sign scheme used by wallets such as
+// MetaMask and WalletConnect.
+func SignPersonalMessage(msg []byte, priv *ecdsa.PrivateKey) ([]byte, error) {
+	return Sign(PersonalMessageHash(msg), priv)
+}
+
+// RecoverPersonalMessage recovers the address that produced VRS over msg's EIP-191
+// personal_sign digest.
+func RecoverPersonalMessage(msg []byte, VRS []byte) (common.Address, error) {
+	return EcRecover(PersonalMessageHash(msg), VRS)
+}
+
+// TypedDataHash computes the EIP-712 digest of typedData, delegating to go-ethereum's own
+// apitypes.TypedDataAndHash so this stays byte-for-byte compatible with upstream signing.
+func TypedDataHash(typedData apitypes.TypedData) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// SignTypedData signs typedData under the EIP-712 domain-separated struct hashing scheme.
+func SignTypedData(typedData apitypes.TypedData, priv *ecdsa.PrivateKey) ([]byte, error) {
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return Sign(hash, priv)
+}
+
+// RecoverTypedData recovers the address that produced VRS over typedData's EIP-712 digest.
+func RecoverTypedData(typedData apitypes.TypedData, VRS []byte) (common.Address, error) {
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return EcRecover(hash, VRS)
+}
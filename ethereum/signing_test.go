@@ -0,0 +1,91 @@
+package ethereum
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+func TestSignEcRecoverRoundTrip(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	want := crypto.PubkeyToAddress(priv.PublicKey)
+
+	hash := crypto.Keccak256Hash([]byte("hello world"))
+	sig, err := Sign(hash, priv)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	addr, err := EcRecover(hash, sig)
+	if err != nil {
+		t.Fatalf("EcRecover: %v", err)
+	}
+	if addr != want {
+		t.Errorf("recovered %s, want %s", addr, want)
+	}
+}
+
+func TestSignPersonalMessageRoundTrip(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	want := crypto.PubkeyToAddress(priv.PublicKey)
+
+	msg := []byte("login to dApp")
+	sig, err := SignPersonalMessage(msg, priv)
+	if err != nil {
+		t.Fatalf("SignPersonalMessage: %v", err)
+	}
+	addr, err := RecoverPersonalMessage(msg, sig)
+	if err != nil {
+		t.Fatalf("RecoverPersonalMessage: %v", err)
+	}
+	if addr != want {
+		t.Errorf("recovered %s, want %s", addr, want)
+	}
+}
+
+func TestSignTypedDataRoundTrip(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	want := crypto.PubkeyToAddress(priv.PublicKey)
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"Mail": {
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: apitypes.TypedDataDomain{
+			Name:    "TestApp",
+			ChainId: math.NewHexOrDecimal256(1),
+		},
+		Message: apitypes.TypedDataMessage{
+			"contents": "hello",
+		},
+	}
+
+	sig, err := SignTypedData(typedData, priv)
+	if err != nil {
+		t.Fatalf("SignTypedData: %v", err)
+	}
+	addr, err := RecoverTypedData(typedData, sig)
+	if err != nil {
+		t.Fatalf("RecoverTypedData: %v", err)
+	}
+	if addr != want {
+		t.Errorf("recovered %s, want %s", addr, want)
+	}
+}